@@ -0,0 +1,158 @@
+package Motor
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// waitPollInterval is how often WaitUntilStopped re-checks the motor's state.
+const waitPollInterval = 20 * time.Millisecond
+
+// Motor caches the sysfs folder backing a single motor so repeated commands
+// don't re-scan rootMotorPath, and provides error-returning variants of the
+// package-level motor commands for callers that can't tolerate log.Fatal on
+// a transient sysfs failure, such as a PID loop running at a fixed period.
+type Motor struct {
+	folder string
+}
+
+// FindMotor locates the motor connected to the given port and returns a
+// Motor caching its sysfs folder for subsequent calls.
+func FindMotor(port OutPort) (*Motor, error) {
+	folder, err := locateFolder(port)
+	if err != nil {
+		return nil, err
+	}
+	return &Motor{folder: folder}, nil
+}
+
+func readString(folder, fd string) (string, error) {
+	data, err := os.ReadFile(path.Join(folder, fd))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func writeString(folder, fd, value string) error {
+	return os.WriteFile(path.Join(folder, fd), []byte(value), 0644)
+}
+
+func writeInt(folder, fd string, value int64) error {
+	return writeString(folder, fd, strconv.FormatInt(value, 10))
+}
+
+func readInt32(folder, fd string) (int32, error) {
+	s, err := readString(folder, fd)
+	if err != nil {
+		return 0, err
+	}
+	v, err := strconv.ParseInt(s, 10, 32)
+	return int32(v), err
+}
+
+func setSpeedE(folder string, speed int16) error {
+	regulationMode, err := readString(folder, regulationModeFD)
+	if err != nil {
+		return err
+	}
+
+	switch regulationMode {
+	case "on":
+		return writeInt(folder, speedSetterFD, int64(speed))
+	case "off":
+		if speed > 100 || speed < -100 {
+			return fmt.Errorf("the speed must be in range [-100, 100], got %d", speed)
+		}
+		return writeInt(folder, powerSetterFD, int64(speed))
+	}
+	return nil
+}
+
+func runE(folder string, speed int16, command RunCommand) error {
+	if err := setSpeedE(folder, speed); err != nil {
+		return err
+	}
+	return writeString(folder, runFD, string(command))
+}
+
+// RunForeverE is like RunForever but returns an error instead of calling
+// log.Fatal if the motor can't be commanded.
+func (m *Motor) RunForeverE(speed int16) error {
+	return runE(m.folder, speed, runForeverCommand)
+}
+
+// RotateE is like Rotate but returns an error instead of calling log.Fatal.
+func (m *Motor) RotateE(angle, speed int16) error {
+	if err := writeInt(m.folder, desiredPositionFD, int64(angle)); err != nil {
+		return err
+	}
+	return runE(m.folder, speed, runToRelPosCommand)
+}
+
+// RotateToE is like RotateTo but returns an error instead of calling log.Fatal.
+func (m *Motor) RotateToE(angle, speed int16) error {
+	if err := writeInt(m.folder, desiredPositionFD, int64(angle)); err != nil {
+		return err
+	}
+	return runE(m.folder, speed, runToAbsPosCommand)
+}
+
+// RunForE is like RunFor but returns an error instead of calling log.Fatal.
+func (m *Motor) RunForE(seconds int32, speed int16) error {
+	if err := writeInt(m.folder, timeFD, int64(seconds)); err != nil {
+		return err
+	}
+	return runE(m.folder, speed, runTimedCommand)
+}
+
+// StopE is like Stop but returns an error instead of calling log.Fatal.
+func (m *Motor) StopE() error {
+	return writeString(m.folder, runFD, "stop")
+}
+
+// SetStopModeE is like SetStopMode but returns an error instead of calling
+// log.Fatal.
+func (m *Motor) SetStopModeE(mode StopMode) error {
+	return writeString(m.folder, stopModeFD, string(mode))
+}
+
+// StateE is like State but returns an error instead of calling log.Fatal.
+func (m *Motor) StateE() (string, error) {
+	return readString(m.folder, stateFD)
+}
+
+// CurrentPositionE is like CurrentPosition but returns an error instead of
+// calling log.Fatal.
+func (m *Motor) CurrentPositionE() (int32, error) {
+	return readInt32(m.folder, positionFD)
+}
+
+// WaitUntilStopped polls the motor's state until it is no longer running,
+// returning once it stops. If timeout is positive and elapses first, it
+// returns an error instead, so a positional move can't hang forever on a
+// motor that never reports stopping.
+func (m *Motor) WaitUntilStopped(timeout time.Duration) error {
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	for {
+		state, err := m.StateE()
+		if err != nil {
+			return err
+		}
+		if !strings.Contains(state, "running") {
+			return nil
+		}
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return fmt.Errorf("motor did not stop within %v", timeout)
+		}
+		time.Sleep(waitPollInterval)
+	}
+}
@@ -0,0 +1,73 @@
+package Motor
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+func newFakeMotorFolder(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for fd, value := range files {
+		if err := os.WriteFile(path.Join(dir, fd), []byte(value), 0644); err != nil {
+			t.Fatalf("writing fake %s: %v", fd, err)
+		}
+	}
+	return dir
+}
+
+func TestRunForeverESetsSpeedAndCommand(t *testing.T) {
+	dir := newFakeMotorFolder(t, map[string]string{
+		regulationModeFD: "off",
+		powerSetterFD:    "0",
+		runFD:            "",
+	})
+	m := &Motor{folder: dir}
+
+	if err := m.RunForeverE(42); err != nil {
+		t.Fatalf("RunForeverE returned error: %v", err)
+	}
+
+	if got, _ := readString(dir, powerSetterFD); got != "42" {
+		t.Errorf("duty_cycle_sp = %q; want 42", got)
+	}
+	if got, _ := readString(dir, runFD); got != string(runForeverCommand) {
+		t.Errorf("command = %q; want %q", got, runForeverCommand)
+	}
+}
+
+func TestRunForeverERejectsOutOfRangeSpeed(t *testing.T) {
+	dir := newFakeMotorFolder(t, map[string]string{
+		regulationModeFD: "off",
+	})
+	m := &Motor{folder: dir}
+
+	if err := m.RunForeverE(150); err == nil {
+		t.Error("RunForeverE(150) should reject a speed outside [-100, 100]")
+	}
+}
+
+func TestWaitUntilStoppedReturnsOnceMotorStops(t *testing.T) {
+	dir := newFakeMotorFolder(t, map[string]string{stateFD: "running"})
+	m := &Motor{folder: dir}
+
+	go func() {
+		time.Sleep(waitPollInterval * 2)
+		os.WriteFile(path.Join(dir, stateFD), []byte(""), 0644)
+	}()
+
+	if err := m.WaitUntilStopped(time.Second); err != nil {
+		t.Errorf("WaitUntilStopped returned error: %v", err)
+	}
+}
+
+func TestWaitUntilStoppedTimesOut(t *testing.T) {
+	dir := newFakeMotorFolder(t, map[string]string{stateFD: "running"})
+	m := &Motor{folder: dir}
+
+	if err := m.WaitUntilStopped(waitPollInterval * 2); err == nil {
+		t.Error("WaitUntilStopped should time out while the motor keeps reporting running")
+	}
+}
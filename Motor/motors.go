@@ -2,6 +2,8 @@
 package Motor
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"os"
 	"path"
@@ -57,30 +59,48 @@ const (
 	desiredPositionFD = "position_sp"
 	timeFD            = "time_sp"
 	countPerRotFD     = "count_per_rot"
+	stateFD           = "state"
 )
 
 func findFolder(port OutPort) string {
+	folder, err := locateFolder(port)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return folder
+}
+
+// locateFolder scans rootMotorPath for the folder backing the motor
+// connected to port. It underlies both findFolder, which gives up with
+// log.Fatal, and FindMotor, which returns the error to the caller instead.
+func locateFolder(port OutPort) (string, error) {
 	if _, err := os.Stat(rootMotorPath); os.IsNotExist(err) {
-		log.Fatal("There are no motors connected")
+		return "", errors.New("there are no motors connected")
 	}
 
-	rootMotorFolder, _ := os.Open(rootMotorPath)
+	rootMotorFolder, err := os.Open(rootMotorPath)
+	if err != nil {
+		return "", err
+	}
 	defer rootMotorFolder.Close()
-	motorFolders, _ := rootMotorFolder.Readdir(-1)
+
+	motorFolders, err := rootMotorFolder.Readdir(-1)
+	if err != nil {
+		return "", err
+	}
 	if len(motorFolders) == 0 {
-		log.Fatal("There are no motors connected")
+		return "", errors.New("there are no motors connected")
 	}
 
 	for _, folderInfo := range motorFolders {
 		folder := folderInfo.Name()
 		motorPort := utilities.ReadStringValue(path.Join(rootMotorPath, folder), portFD)
 		if motorPort == "out"+string(port) {
-			return path.Join(rootMotorPath, folder)
+			return path.Join(rootMotorPath, folder), nil
 		}
 	}
 
-	log.Fatal("No motor is connected to port ", port)
-	return ""
+	return "", fmt.Errorf("no motor is connected to port %s", port)
 }
 
 func setSpeed(folder string, speed int16) {
@@ -189,3 +209,10 @@ func CurrentPosition(port OutPort) int32 {
 func InitializePosition(port OutPort, value int32) {
 	utilities.WriteIntValue(findFolder(port), positionFD, int64(value))
 }
+
+// State reads the operating state of the motor at the given port, e.g.
+// "running" or "holding". It can be polled to find out when a positional
+// or timed move has finished.
+func State(port OutPort) string {
+	return utilities.ReadStringValue(findFolder(port), stateFD)
+}
@@ -0,0 +1,255 @@
+// Package steering Provides a steering block for driving two motors as a
+// synchronized pair, similar to the EV-G "Steering" block.
+package steering
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ldmberman/GoEV3/Motor"
+)
+
+// pollInterval is how often Steer polls motor state while waiting for a
+// timed or positional move to finish.
+const pollInterval = 20 * time.Millisecond
+
+// driver abstracts the Motor calls a Steer depends on so tests can
+// substitute a fake motor pair instead of driving real sysfs.
+type driver interface {
+	RunForever(port Motor.OutPort, speed int16) error
+	Rotate(port Motor.OutPort, angle, speed int16) error
+	RunFor(port Motor.OutPort, time int32, speed int16) error
+	Stop(port Motor.OutPort) error
+	State(port Motor.OutPort) (string, error)
+}
+
+// realDriver runs commands through the cached, error-returning Motor.Motor
+// API instead of the package-level, log.Fatal-on-error Motor functions, and
+// caches each port's *Motor.Motor so a tight polling loop like
+// waitUntilStopped doesn't re-scan sysfs on every iteration.
+type realDriver struct {
+	mu     sync.Mutex
+	motors map[Motor.OutPort]*Motor.Motor
+}
+
+func newRealDriver() *realDriver {
+	return &realDriver{motors: make(map[Motor.OutPort]*Motor.Motor)}
+}
+
+func (d *realDriver) motor(port Motor.OutPort) (*Motor.Motor, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if m, ok := d.motors[port]; ok {
+		return m, nil
+	}
+	m, err := Motor.FindMotor(port)
+	if err != nil {
+		return nil, err
+	}
+	d.motors[port] = m
+	return m, nil
+}
+
+func (d *realDriver) RunForever(port Motor.OutPort, speed int16) error {
+	m, err := d.motor(port)
+	if err != nil {
+		return err
+	}
+	return m.RunForeverE(speed)
+}
+
+func (d *realDriver) Rotate(port Motor.OutPort, angle, speed int16) error {
+	m, err := d.motor(port)
+	if err != nil {
+		return err
+	}
+	return m.RotateE(angle, speed)
+}
+
+func (d *realDriver) RunFor(port Motor.OutPort, seconds int32, speed int16) error {
+	m, err := d.motor(port)
+	if err != nil {
+		return err
+	}
+	return m.RunForE(seconds, speed)
+}
+
+func (d *realDriver) Stop(port Motor.OutPort) error {
+	m, err := d.motor(port)
+	if err != nil {
+		return err
+	}
+	return m.StopE()
+}
+
+func (d *realDriver) State(port Motor.OutPort) (string, error) {
+	m, err := d.motor(port)
+	if err != nil {
+		return "", err
+	}
+	return m.StateE()
+}
+
+var motors driver = newRealDriver()
+
+// Steer drives a pair of motors together, applying a steering ratio so the
+// pair can go straight, curve, or pivot in place.
+type Steer struct {
+	Left  Motor.OutPort
+	Right Motor.OutPort
+	// Timeout bounds how long SteerDistance and SteerTime will wait for both
+	// motors to stop on their own. Zero means wait indefinitely.
+	Timeout time.Duration
+}
+
+// speeds returns the left and right motor speeds for the given steering
+// ratio and target speed. steering ranges from -100 (pivot left) to 100
+// (pivot right); 0 drives straight. The slower wheel's speed is scaled by
+// (100-2*|steering|)/100, which goes negative once |steering| is over 50,
+// reversing that wheel so the pair pivots in place.
+func speeds(steering int, speed int16) (left, right int16) {
+	if steering < -100 {
+		steering = -100
+	} else if steering > 100 {
+		steering = 100
+	}
+
+	abs := steering
+	if abs < 0 {
+		abs = -abs
+	}
+	scaled := int16(int(speed) * (100 - 2*abs) / 100)
+
+	if steering >= 0 {
+		return speed, scaled
+	}
+	return scaled, speed
+}
+
+// SteerForever drives the pair indefinitely at the given steering ratio and
+// speed. Call Stop to halt the pair.
+func (s Steer) SteerForever(steering int, speed int16) error {
+	left, right := speeds(steering, speed)
+	return s.start(func(port Motor.OutPort, spd int16) error { return motors.RunForever(port, spd) }, left, right)
+}
+
+// maxRotateDegrees is the largest magnitude SteerDistance can pass to
+// motors.Rotate in one call, which takes an int16 angle.
+const maxRotateDegrees = 32767
+
+// SteerDistance drives the pair through the given number of degrees at the
+// given steering ratio and speed. degrees may exceed motors.Rotate's int16
+// range; SteerDistance splits it into maxRotateDegrees-sized chunks and
+// drives them one after another. Both motors are started together for each
+// chunk and the call blocks until both have stopped, or Timeout has
+// elapsed, before moving to the next chunk or stopping them together.
+func (s Steer) SteerDistance(steering int, speed int16, degrees int32) error {
+	left, right := speeds(steering, speed)
+
+	remaining := degrees
+	negative := remaining < 0
+	if negative {
+		remaining = -remaining
+	}
+
+	for remaining > 0 {
+		chunk := remaining
+		if chunk > maxRotateDegrees {
+			chunk = maxRotateDegrees
+		}
+		remaining -= chunk
+
+		angle := int16(chunk)
+		if negative {
+			angle = -angle
+		}
+		if err := s.start(func(port Motor.OutPort, spd int16) error { return motors.Rotate(port, angle, spd) }, left, right); err != nil {
+			return err
+		}
+		if err := s.waitUntilStopped(); err != nil {
+			return err
+		}
+	}
+
+	return s.Stop()
+}
+
+// SteerTime drives the pair for the given duration in milliseconds at the
+// given steering ratio and speed. Both motors are started together and the
+// call blocks until both have stopped, or Timeout has elapsed, before
+// stopping them together.
+func (s Steer) SteerTime(steering int, speed int16, ms int32) error {
+	left, right := speeds(steering, speed)
+	if err := s.start(func(port Motor.OutPort, spd int16) error { return motors.RunFor(port, ms, spd) }, left, right); err != nil {
+		return err
+	}
+	if err := s.waitUntilStopped(); err != nil {
+		return err
+	}
+	return s.Stop()
+}
+
+// Stop stops both motors together.
+func (s Steer) Stop() error {
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	wg.Add(2)
+	go func() { defer wg.Done(); errs[0] = motors.Stop(s.Left) }()
+	go func() { defer wg.Done(); errs[1] = motors.Stop(s.Right) }()
+	wg.Wait()
+	if errs[0] != nil {
+		return errs[0]
+	}
+	return errs[1]
+}
+
+// start issues cmd to both motors at roughly the same time, so neither wheel
+// gets a head start on the other.
+func (s Steer) start(cmd func(port Motor.OutPort, speed int16) error, left, right int16) error {
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	wg.Add(2)
+	go func() { defer wg.Done(); errs[0] = cmd(s.Left, left) }()
+	go func() { defer wg.Done(); errs[1] = cmd(s.Right, right) }()
+	wg.Wait()
+	if errs[0] != nil {
+		return errs[0]
+	}
+	return errs[1]
+}
+
+// waitUntilStopped polls both motors' state until neither is running, or
+// until Timeout elapses if it is set.
+func (s Steer) waitUntilStopped() error {
+	var deadline time.Time
+	if s.Timeout > 0 {
+		deadline = time.Now().Add(s.Timeout)
+	}
+	for {
+		leftRunning, err := isRunning(s.Left)
+		if err != nil {
+			return err
+		}
+		rightRunning, err := isRunning(s.Right)
+		if err != nil {
+			return err
+		}
+		if !leftRunning && !rightRunning {
+			return nil
+		}
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return nil
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+func isRunning(port Motor.OutPort) (bool, error) {
+	state, err := motors.State(port)
+	if err != nil {
+		return false, err
+	}
+	return strings.Contains(state, "running"), nil
+}
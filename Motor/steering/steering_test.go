@@ -0,0 +1,147 @@
+package steering
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ldmberman/GoEV3/Motor"
+)
+
+// fakeDriver stands in for a pair of motors without touching sysfs: it
+// records every command in memory and lets the test decide when each port
+// stops "running".
+type fakeDriver struct {
+	mu      sync.Mutex
+	speed   map[Motor.OutPort]int16
+	state   map[Motor.OutPort]string
+	stopped map[Motor.OutPort]bool
+}
+
+func newFakeDriver() *fakeDriver {
+	return &fakeDriver{
+		speed:   make(map[Motor.OutPort]int16),
+		state:   make(map[Motor.OutPort]string),
+		stopped: make(map[Motor.OutPort]bool),
+	}
+}
+
+func (f *fakeDriver) RunForever(port Motor.OutPort, speed int16) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.speed[port] = speed
+	f.state[port] = "running"
+	return nil
+}
+
+func (f *fakeDriver) Rotate(port Motor.OutPort, angle, speed int16) error {
+	return f.RunForever(port, speed)
+}
+
+func (f *fakeDriver) RunFor(port Motor.OutPort, time int32, speed int16) error {
+	return f.RunForever(port, speed)
+}
+
+func (f *fakeDriver) Stop(port Motor.OutPort) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.stopped[port] = true
+	f.state[port] = ""
+	return nil
+}
+
+func (f *fakeDriver) State(port Motor.OutPort) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.state[port], nil
+}
+
+func (f *fakeDriver) finish(port Motor.OutPort) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.state[port] = ""
+}
+
+func withFakeDriver(t *testing.T) *fakeDriver {
+	t.Helper()
+	f := newFakeDriver()
+	old := motors
+	motors = f
+	t.Cleanup(func() { motors = old })
+	return f
+}
+
+func TestSpeedsStraight(t *testing.T) {
+	left, right := speeds(0, 50)
+	if left != 50 || right != 50 {
+		t.Errorf("speeds(0, 50) = %d, %d; want 50, 50", left, right)
+	}
+}
+
+func TestSpeedsPivotAtLimit(t *testing.T) {
+	left, right := speeds(100, 50)
+	if left != 50 || right != -50 {
+		t.Errorf("speeds(100, 50) = %d, %d; want 50, -50", left, right)
+	}
+
+	left, right = speeds(-100, 50)
+	if left != -50 || right != 50 {
+		t.Errorf("speeds(-100, 50) = %d, %d; want -50, 50", left, right)
+	}
+}
+
+func TestSpeedsHalfSlowsInnerWheelToZero(t *testing.T) {
+	left, right := speeds(50, 80)
+	if left != 80 || right != 0 {
+		t.Errorf("speeds(50, 80) = %d, %d; want 80, 0", left, right)
+	}
+}
+
+func TestSpeedsClampsOutOfRangeSteering(t *testing.T) {
+	left, right := speeds(200, 50)
+	if left != 50 || right != -50 {
+		t.Errorf("speeds(200, 50) = %d, %d; want 50, -50", left, right)
+	}
+}
+
+func TestSteerForeverStartsBothMotors(t *testing.T) {
+	f := withFakeDriver(t)
+	s := Steer{Left: Motor.OutPortA, Right: Motor.OutPortB}
+
+	s.SteerForever(0, 40)
+
+	if f.speed[Motor.OutPortA] != 40 || f.speed[Motor.OutPortB] != 40 {
+		t.Errorf("got speeds %d, %d; want 40, 40", f.speed[Motor.OutPortA], f.speed[Motor.OutPortB])
+	}
+}
+
+func TestSteerDistanceWaitsForBothMotorsToStop(t *testing.T) {
+	f := withFakeDriver(t)
+	s := Steer{Left: Motor.OutPortA, Right: Motor.OutPortB, Timeout: time.Second}
+
+	go func() {
+		time.Sleep(pollInterval * 2)
+		f.finish(Motor.OutPortA)
+		f.finish(Motor.OutPortB)
+	}()
+
+	s.SteerDistance(0, 50, 360)
+
+	if !f.stopped[Motor.OutPortA] || !f.stopped[Motor.OutPortB] {
+		t.Error("expected both motors to be stopped after SteerDistance returns")
+	}
+}
+
+func TestSteerDistanceRespectsTimeout(t *testing.T) {
+	f := withFakeDriver(t)
+	s := Steer{Left: Motor.OutPortA, Right: Motor.OutPortB, Timeout: pollInterval * 2}
+
+	start := time.Now()
+	s.SteerDistance(0, 50, 360)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("SteerDistance took %v; expected it to give up after the timeout", elapsed)
+	}
+	if !f.stopped[Motor.OutPortA] || !f.stopped[Motor.OutPortB] {
+		t.Error("expected both motors to be stopped once the timeout elapses")
+	}
+}
@@ -0,0 +1,37 @@
+package Sensors
+
+import (
+	"fmt"
+
+	"github.com/ldmberman/GoEV3/utilities"
+)
+
+// GyroSensor type.
+type GyroSensor struct {
+	port InPort
+}
+
+// FindGyroSensor provides access to a gyro sensor at the given port. The
+// sensor is read in its combined GYRO-G&A mode, which reports both an
+// accumulated angle and the instantaneous rotation rate.
+func FindGyroSensor(port InPort) *GyroSensor {
+	findSensor(port, TypeGyro)
+
+	s := new(GyroSensor)
+	s.port = port
+	return s
+}
+
+// Angle reads the accumulated rotation angle in degrees.
+func (sensor *GyroSensor) Angle() int16 {
+	snr := findSensor(sensor.port, TypeGyro)
+	path := fmt.Sprintf("%s/%s", baseSensorPath, snr)
+	return utilities.ReadInt16Value(path, "value0")
+}
+
+// Rate reads the instantaneous rotation rate in degrees per second.
+func (sensor *GyroSensor) Rate() int16 {
+	snr := findSensor(sensor.port, TypeGyro)
+	path := fmt.Sprintf("%s/%s", baseSensorPath, snr)
+	return utilities.ReadInt16Value(path, "value1")
+}
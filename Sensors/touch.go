@@ -2,17 +2,28 @@ package Sensors
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ldmberman/GoEV3/utilities"
 )
 
+// pollInterval is how often TouchSensor polls its value while listening.
+const pollInterval = 50 * time.Millisecond
+
 // TouchSensor type.
 type TouchSensor struct {
-	port        InPort
-	isListening bool
-	chStop      chan bool
-	channels    []chan uint8
+	port    InPort
+	watcher *Watcher
+
+	mu     sync.Mutex
+	relays map[chan uint8]touchRelay
+}
+
+type touchRelay struct {
+	ch   chan int32
+	sub  Subscription
+	done chan struct{}
 }
 
 // FindTouchSensor provides access to a touch sensor at the given port.
@@ -20,73 +31,74 @@ func FindTouchSensor(port InPort) *TouchSensor {
 	findSensor(port, TypeTouch)
 
 	s := new(TouchSensor)
-	s.isListening = false
 	s.port = port
-	s.chStop = make(chan bool)
+	s.relays = make(map[chan uint8]touchRelay)
+	s.watcher = NewWatcher(func() (int32, error) {
+		snr := findSensor(port, TypeTouch)
+		path := fmt.Sprintf("%s/%s", baseSensorPath, snr)
+		return int32(utilities.ReadUInt8Value(path, "value0")), nil
+	}, pollInterval)
 
 	return s
 }
 
-func sendEvent(ch chan uint8, val uint8) {
-	ch <- val
+// Watch exposes the sensor's underlying Watcher, so callers can use its
+// full filtering API (OnRisingEdge, OnThreshold, debouncing, ...) instead
+// of the simpler Notify below.
+func (sensor *TouchSensor) Watch() *Watcher {
+	return sensor.watcher
 }
 
-// StartListening starts another go routine that listens for changes in the touch sensor
+// StartListening starts polling the touch sensor for changes.
 func (sensor *TouchSensor) StartListening() {
-	if sensor.isListening {
-		return
-	}
-	go func() {
-		snr := findSensor(sensor.port, TypeTouch)
-		path := fmt.Sprintf("%s/%s", baseSensorPath, snr)
-		curVal := utilities.ReadUInt8Value(path, "value0")
-
-		for {
-			select {
-			case <-sensor.chStop:
-				return
-			default:
-				value := utilities.ReadUInt8Value(path, "value0")
-				if value != curVal {
-					for _, ch := range sensor.channels {
-						go sendEvent(ch, value)
-					}
-					curVal = value
-				}
-			}
-			time.Sleep(time.Millisecond * 50)
-		}
-	}()
-	sensor.isListening = true
+	sensor.watcher.Start()
 }
 
 // StopListening stops listening for changes in the touch sensor
 func (sensor *TouchSensor) StopListening() {
-	if sensor.isListening {
-		sensor.chStop <- true
-		sensor.isListening = false
-	}
+	sensor.watcher.Stop()
 }
 
 // Notify adds the chanel to a list of channels to send Touch data.
+//
+// Deprecated: use Watch().OnChange, which doesn't miss events raised
+// between two subscribers being added and fixes the lack of synchronization
+// this method used to have around the subscriber list.
 func (sensor *TouchSensor) Notify(ch chan uint8) {
-	if sensor.indexOf(ch) == -1 {
-		sensor.channels = append(sensor.channels, ch)
+	sensor.mu.Lock()
+	defer sensor.mu.Unlock()
+
+	if _, ok := sensor.relays[ch]; ok {
+		return
+	}
+
+	relayCh := make(chan int32, 1)
+	done := make(chan struct{})
+	sensor.relays[ch] = touchRelay{
+		ch:   relayCh,
+		sub:  sensor.watcher.OnChange(relayCh, Options{}),
+		done: done,
 	}
+	go func() {
+		for {
+			select {
+			case v := <-relayCh:
+				ch <- uint8(v)
+			case <-done:
+				return
+			}
+		}
+	}()
 }
 
 // StopNotify removes the chanel from the list of channels to send Touch data.
 func (sensor *TouchSensor) StopNotify(ch chan uint8) {
-	if i := sensor.indexOf(ch); i != -1 {
-		sensor.channels = append(sensor.channels[:i], sensor.channels[i+1:]...)
-	}
-}
+	sensor.mu.Lock()
+	defer sensor.mu.Unlock()
 
-func (sensor *TouchSensor) indexOf(ch chan uint8) int {
-	for i, val := range sensor.channels {
-		if val == ch {
-			return i
-		}
+	if r, ok := sensor.relays[ch]; ok {
+		r.sub.Unsubscribe()
+		close(r.done)
+		delete(sensor.relays, ch)
 	}
-	return -1
 }
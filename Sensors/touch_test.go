@@ -0,0 +1,44 @@
+package Sensors
+
+import (
+	"sync"
+	"testing"
+)
+
+// newTestTouchSensor builds a TouchSensor around an in-memory Watcher,
+// bypassing FindTouchSensor's sysfs lookup so Notify/StopNotify can be
+// exercised without real hardware.
+func newTestTouchSensor(read ValueReader) *TouchSensor {
+	return &TouchSensor{
+		relays:  make(map[chan uint8]touchRelay),
+		watcher: NewWatcher(read, testPollInterval),
+	}
+}
+
+// alternatingReader flips between 0 and 1 on every call, so a fast poller
+// keeps dispatching changes to subscribers for the duration of the test.
+func alternatingReader() ValueReader {
+	var v int32
+	return func() (int32, error) {
+		v ^= 1
+		return v, nil
+	}
+}
+
+func TestNotifyStopNotifyConcurrentDoesNotPanic(t *testing.T) {
+	sensor := newTestTouchSensor(alternatingReader())
+	sensor.StartListening()
+	defer sensor.StopListening()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ch := make(chan uint8, 1)
+			sensor.Notify(ch)
+			sensor.StopNotify(ch)
+		}()
+	}
+	wg.Wait()
+}
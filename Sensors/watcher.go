@@ -0,0 +1,240 @@
+package Sensors
+
+import (
+	"sync"
+	"time"
+)
+
+// ValueReader reads the current value a Watcher polls, e.g. a sensor's
+// value0 sysfs attribute. An error is treated as a skipped poll: no
+// subscriber is notified and the previous value is kept for edge detection.
+type ValueReader func() (int32, error)
+
+// OverflowPolicy controls what a Watcher does when a subscriber's channel
+// is full.
+type OverflowPolicy int
+
+const (
+	// DropOldest discards the oldest buffered event to make room for the new one.
+	DropOldest OverflowPolicy = iota
+	// DropNewest discards the incoming event, leaving the buffer as is.
+	DropNewest
+)
+
+// Options configures a single subscription.
+type Options struct {
+	// Debounce, if positive, suppresses events delivered less than Debounce
+	// after the previous one sent to this subscriber.
+	Debounce time.Duration
+	// Overflow picks what happens when this subscriber's channel is full.
+	Overflow OverflowPolicy
+}
+
+type filterFunc func(prev, cur int32, hasPrev bool) bool
+
+type subscription struct {
+	ch       chan int32
+	filter   filterFunc
+	overflow OverflowPolicy
+	debounce time.Duration
+	lastSent time.Time
+}
+
+// Subscription is a handle returned by a Watcher's On* methods; call
+// Unsubscribe to stop receiving events on the channel passed in.
+type Subscription struct {
+	w *Watcher
+	s *subscription
+}
+
+// Unsubscribe removes the subscription from its Watcher.
+func (sub Subscription) Unsubscribe() {
+	sub.w.remove(sub.s)
+}
+
+// Watcher polls a value on a fixed interval and dispatches it to
+// subscribers that only care about certain kinds of change: any change, a
+// rising or falling edge, or crossing a threshold. It generalizes the
+// goroutine-based polling loop TouchSensor used to hard-code, so any sensor
+// can plug a ValueReader into it.
+type Watcher struct {
+	read     ValueReader
+	interval time.Duration
+
+	mu          sync.RWMutex
+	subscribers []*subscription
+	running     bool
+	chStop      chan struct{}
+}
+
+// NewWatcher creates a Watcher that will poll read every interval once
+// Start is called.
+func NewWatcher(read ValueReader, interval time.Duration) *Watcher {
+	return &Watcher{read: read, interval: interval}
+}
+
+// Start begins polling in a background goroutine. It is a no-op if the
+// Watcher is already running.
+func (w *Watcher) Start() {
+	w.mu.Lock()
+	if w.running {
+		w.mu.Unlock()
+		return
+	}
+	w.running = true
+	w.chStop = make(chan struct{})
+	w.mu.Unlock()
+
+	go w.loop()
+}
+
+// Stop halts polling.
+func (w *Watcher) Stop() {
+	w.mu.Lock()
+	if !w.running {
+		w.mu.Unlock()
+		return
+	}
+	w.running = false
+	chStop := w.chStop
+	w.mu.Unlock()
+
+	close(chStop)
+}
+
+func (w *Watcher) loop() {
+	w.mu.RLock()
+	chStop := w.chStop
+	w.mu.RUnlock()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	var prev int32
+	hasPrev := false
+	for {
+		select {
+		case <-chStop:
+			return
+		case <-ticker.C:
+			cur, err := w.read()
+			if err != nil {
+				continue
+			}
+
+			w.mu.RLock()
+			subs := make([]*subscription, len(w.subscribers))
+			copy(subs, w.subscribers)
+			w.mu.RUnlock()
+
+			for _, s := range subs {
+				w.dispatch(s, prev, cur, hasPrev)
+			}
+			prev, hasPrev = cur, true
+		}
+	}
+}
+
+func (w *Watcher) dispatch(s *subscription, prev, cur int32, hasPrev bool) {
+	if !s.filter(prev, cur, hasPrev) {
+		return
+	}
+	if s.debounce > 0 && !s.lastSent.IsZero() && time.Since(s.lastSent) < s.debounce {
+		return
+	}
+	s.lastSent = time.Now()
+
+	select {
+	case s.ch <- cur:
+		return
+	default:
+	}
+
+	switch s.overflow {
+	case DropOldest:
+		select {
+		case <-s.ch:
+		default:
+		}
+		select {
+		case s.ch <- cur:
+		default:
+		}
+	case DropNewest:
+	}
+}
+
+func (w *Watcher) subscribe(ch chan int32, filter filterFunc, opts Options) Subscription {
+	s := &subscription{ch: ch, filter: filter, overflow: opts.Overflow, debounce: opts.Debounce}
+
+	w.mu.Lock()
+	w.subscribers = append(w.subscribers, s)
+	w.mu.Unlock()
+
+	return Subscription{w: w, s: s}
+}
+
+func (w *Watcher) remove(target *subscription) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for i, s := range w.subscribers {
+		if s == target {
+			w.subscribers = append(w.subscribers[:i], w.subscribers[i+1:]...)
+			return
+		}
+	}
+}
+
+// OnChange delivers cur on ch on the first poll and whenever it differs from
+// the previous polled value.
+func (w *Watcher) OnChange(ch chan int32, opts Options) Subscription {
+	return w.subscribe(ch, func(prev, cur int32, hasPrev bool) bool {
+		return !hasPrev || cur != prev
+	}, opts)
+}
+
+// OnRisingEdge delivers cur on ch whenever the value moves from at-or-below
+// threshold to above it.
+func (w *Watcher) OnRisingEdge(ch chan int32, threshold int32, opts Options) Subscription {
+	return w.subscribe(ch, func(prev, cur int32, hasPrev bool) bool {
+		return hasPrev && prev <= threshold && cur > threshold
+	}, opts)
+}
+
+// OnFallingEdge delivers cur on ch whenever the value moves from at-or-above
+// threshold to below it.
+func (w *Watcher) OnFallingEdge(ch chan int32, threshold int32, opts Options) Subscription {
+	return w.subscribe(ch, func(prev, cur int32, hasPrev bool) bool {
+		return hasPrev && prev >= threshold && cur < threshold
+	}, opts)
+}
+
+// OnThreshold delivers cur on ch once it crosses threshold in the given
+// direction (above it if above is true, below it otherwise). hysteresis is
+// a dead band the value must cross back over before the subscription will
+// fire again, which keeps a noisy analog reading (light, ultrasonic, etc.)
+// hovering near the threshold from re-triggering on every poll.
+func (w *Watcher) OnThreshold(ch chan int32, threshold, hysteresis int32, above bool, opts Options) Subscription {
+	armed := true
+	return w.subscribe(ch, func(prev, cur int32, hasPrev bool) bool {
+		var triggered bool
+		if above {
+			triggered = cur > threshold
+		} else {
+			triggered = cur < threshold
+		}
+
+		if triggered && armed {
+			armed = false
+			return true
+		}
+		if !triggered {
+			if above && cur < threshold-hysteresis {
+				armed = true
+			} else if !above && cur > threshold+hysteresis {
+				armed = true
+			}
+		}
+		return false
+	}, opts)
+}
@@ -0,0 +1,130 @@
+package Sensors
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testPollInterval = 5 * time.Millisecond
+
+// sequenceReader returns a ValueReader that walks through values once per
+// call, holding on the last one once exhausted.
+func sequenceReader(values []int32) ValueReader {
+	var i int32
+	return func() (int32, error) {
+		idx := atomic.LoadInt32(&i)
+		if int(idx) < len(values)-1 {
+			atomic.AddInt32(&i, 1)
+		}
+		return values[idx], nil
+	}
+}
+
+func recvWithin(t *testing.T, ch chan int32, timeout time.Duration) (int32, bool) {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v, true
+	case <-time.After(timeout):
+		return 0, false
+	}
+}
+
+func TestWatcherOnChangeDeliversFirstAndChangedValues(t *testing.T) {
+	w := NewWatcher(sequenceReader([]int32{1, 1, 1, 2, 2, 3}), testPollInterval)
+	ch := make(chan int32, 4)
+	w.OnChange(ch, Options{})
+	w.Start()
+	defer w.Stop()
+
+	first, ok := recvWithin(t, ch, time.Second)
+	if !ok || first != 1 {
+		t.Fatalf("first value = %v, ok=%v; want 1, true", first, ok)
+	}
+	second, ok := recvWithin(t, ch, time.Second)
+	if !ok || second != 2 {
+		t.Fatalf("second value = %v, ok=%v; want 2, true", second, ok)
+	}
+	third, ok := recvWithin(t, ch, time.Second)
+	if !ok || third != 3 {
+		t.Fatalf("third value = %v, ok=%v; want 3, true", third, ok)
+	}
+}
+
+func TestWatcherOnRisingEdgeFiresOnlyOnCross(t *testing.T) {
+	w := NewWatcher(sequenceReader([]int32{0, 0, 10, 10, 0, 10}), testPollInterval)
+	ch := make(chan int32, 4)
+	w.OnRisingEdge(ch, 5, Options{})
+	w.Start()
+	defer w.Stop()
+
+	v, ok := recvWithin(t, ch, time.Second)
+	if !ok || v != 10 {
+		t.Fatalf("first edge = %v, ok=%v; want 10, true", v, ok)
+	}
+	v, ok = recvWithin(t, ch, time.Second)
+	if !ok || v != 10 {
+		t.Fatalf("second edge = %v, ok=%v; want 10, true", v, ok)
+	}
+	if _, ok := recvWithin(t, ch, testPollInterval*3); ok {
+		t.Fatal("OnRisingEdge should not fire again without crossing back down first")
+	}
+}
+
+func TestWatcherOnThresholdHysteresisSuppressesNoise(t *testing.T) {
+	// Hovers just above/below the threshold without leaving the dead band;
+	// only the initial crossing at index 2 should fire.
+	w := NewWatcher(sequenceReader([]int32{0, 0, 12, 8, 12, 8, 12}), testPollInterval)
+	ch := make(chan int32, 4)
+	w.OnThreshold(ch, 10, 5, true, Options{})
+	w.Start()
+	defer w.Stop()
+
+	v, ok := recvWithin(t, ch, time.Second)
+	if !ok || v != 12 {
+		t.Fatalf("threshold crossing = %v, ok=%v; want 12, true", v, ok)
+	}
+	if _, ok := recvWithin(t, ch, testPollInterval*5); ok {
+		t.Fatal("OnThreshold should stay suppressed while noise stays inside the hysteresis band")
+	}
+}
+
+func TestWatcherDebounceSuppressesRapidRepeats(t *testing.T) {
+	w := NewWatcher(sequenceReader([]int32{1, 2, 3, 4, 5}), testPollInterval)
+	ch := make(chan int32, 8)
+	w.OnChange(ch, Options{Debounce: time.Second})
+	w.Start()
+	defer w.Stop()
+
+	_, ok := recvWithin(t, ch, time.Second)
+	if !ok {
+		t.Fatal("expected the first change to be delivered")
+	}
+	if _, ok := recvWithin(t, ch, testPollInterval*5); ok {
+		t.Fatal("subsequent changes should be suppressed during the debounce window")
+	}
+}
+
+func TestWatcherUnsubscribeStopsDelivery(t *testing.T) {
+	w := NewWatcher(sequenceReader([]int32{1, 2, 3}), testPollInterval)
+	ch := make(chan int32, 4)
+	sub := w.OnChange(ch, Options{})
+	w.Start()
+	defer w.Stop()
+
+	if _, ok := recvWithin(t, ch, time.Second); !ok {
+		t.Fatal("expected at least one value before unsubscribing")
+	}
+	sub.Unsubscribe()
+
+	// Drain anything already in flight, then make sure nothing more arrives.
+	for {
+		if _, ok := recvWithin(t, ch, testPollInterval); !ok {
+			break
+		}
+	}
+	if _, ok := recvWithin(t, ch, testPollInterval*5); ok {
+		t.Fatal("no values should be delivered after Unsubscribe")
+	}
+}
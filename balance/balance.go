@@ -0,0 +1,245 @@
+// Package balance implements a two-wheeled self-balancing controller,
+// GyroBoy-style, that fuses a gyro sensor's rate with motor tacho counts and
+// drives a pair of motors through a PID loop.
+package balance
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ldmberman/GoEV3/Motor"
+	"github.com/ldmberman/GoEV3/Sensors"
+)
+
+// Config holds the gains and timing for the balancing PID loop.
+type Config struct {
+	// Kp, Ki, Kd weight the tilt angle, its integral, and the gyro rate.
+	Kp, Ki, Kd float64
+	// Kpos and Kspd weight the average motor position and speed, which keep
+	// the robot from drifting as it balances.
+	Kpos, Kspd float64
+	// Period is how often the loop re-samples the gyro and updates the motors.
+	Period time.Duration
+	// CalibrationTime is how long Start averages the gyro rate at rest to
+	// estimate its drift offset before balancing begins.
+	CalibrationTime time.Duration
+	// FallAngle is the tilt, in degrees, beyond which the robot is considered
+	// to have fallen.
+	FallAngle float64
+	// FallDuration is how long the tilt must stay beyond FallAngle before
+	// the controller stops the motors and gives up.
+	FallDuration time.Duration
+}
+
+// DefaultConfig returns gains tuned for the standard EV3 two-wheeled
+// balancing build.
+func DefaultConfig() Config {
+	return Config{
+		Kp:              0.8,
+		Ki:              15,
+		Kd:              0.005,
+		Kpos:            0.07,
+		Kspd:            0.1,
+		Period:          10 * time.Millisecond,
+		CalibrationTime: 2 * time.Second,
+		FallAngle:       45,
+		FallDuration:    time.Second,
+	}
+}
+
+// motorDriver is the subset of *Motor.Motor the balancing loop depends on,
+// so tests can substitute a fake motor instead of driving real sysfs.
+type motorDriver interface {
+	CurrentPositionE() (int32, error)
+	RunForeverE(speed int16) error
+	StopE() error
+}
+
+// gyroReader is the subset of *Sensors.GyroSensor the balancing loop
+// depends on, so tests can substitute a fake gyro instead of driving real
+// sysfs.
+type gyroReader interface {
+	Rate() int16
+}
+
+// Controller balances a two-wheeled robot and accepts drive commands while
+// it does so.
+type Controller struct {
+	cfg         Config
+	left, right motorDriver
+	gyro        gyroReader
+
+	stop    chan struct{}
+	done    chan struct{}
+	stopped bool
+	mu      sync.Mutex
+
+	forward, steer int8
+}
+
+// Start calibrates the gyro and launches the balancing loop in a background
+// goroutine, using DefaultConfig's gains.
+func Start(leftPort, rightPort Motor.OutPort, gyroPort Sensors.InPort) (*Controller, error) {
+	return StartWithConfig(leftPort, rightPort, gyroPort, DefaultConfig())
+}
+
+// StartWithConfig is like Start but with caller-supplied gains and timing.
+func StartWithConfig(leftPort, rightPort Motor.OutPort, gyroPort Sensors.InPort, cfg Config) (*Controller, error) {
+	if cfg.Period <= 0 {
+		return nil, fmt.Errorf("balance: Period must be positive, got %v", cfg.Period)
+	}
+
+	left, err := Motor.FindMotor(leftPort)
+	if err != nil {
+		return nil, fmt.Errorf("balance: %w", err)
+	}
+	right, err := Motor.FindMotor(rightPort)
+	if err != nil {
+		return nil, fmt.Errorf("balance: %w", err)
+	}
+
+	c := &Controller{
+		cfg:   cfg,
+		left:  left,
+		right: right,
+		gyro:  Sensors.FindGyroSensor(gyroPort),
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+
+	go c.run(c.calibrate())
+	return c, nil
+}
+
+// Drive sets the forward/back and turning commands the balancing loop mixes
+// into its output. Both range roughly from -100 to 100.
+func (c *Controller) Drive(forward, steer int8) {
+	c.mu.Lock()
+	c.forward, c.steer = forward, steer
+	c.mu.Unlock()
+}
+
+// Stop halts the balancing loop and stops both motors.
+func (c *Controller) Stop() {
+	c.mu.Lock()
+	if c.stopped {
+		c.mu.Unlock()
+		return
+	}
+	c.stopped = true
+	c.mu.Unlock()
+
+	close(c.stop)
+	<-c.done
+}
+
+// calibrate averages the gyro's rate at rest to estimate its drift offset,
+// the same way ev3dev-lang-java's GyroBoy sample does.
+func (c *Controller) calibrate() float64 {
+	if c.cfg.CalibrationTime <= 0 {
+		return 0
+	}
+
+	ticker := time.NewTicker(c.cfg.Period)
+	defer ticker.Stop()
+
+	var offset float64
+	deadline := time.Now().Add(c.cfg.CalibrationTime)
+	for time.Now().Before(deadline) {
+		<-ticker.C
+		rate := float64(c.gyro.Rate())
+		offset = 0.0005*rate + 0.9995*offset
+	}
+	return offset
+}
+
+func (c *Controller) run(gyroOffset float64) {
+	defer close(c.done)
+
+	ticker := time.NewTicker(c.cfg.Period)
+	defer ticker.Stop()
+	dt := c.cfg.Period.Seconds()
+
+	leftStart, err := c.left.CurrentPositionE()
+	if err != nil {
+		return
+	}
+	rightStart, err := c.right.CurrentPositionE()
+	if err != nil {
+		return
+	}
+
+	var angle, integral, prevMotorAngle float64
+	var fallSince time.Time
+
+	for {
+		select {
+		case <-c.stop:
+			c.stopMotors()
+			return
+		case <-ticker.C:
+		}
+
+		rate := float64(c.gyro.Rate()) - gyroOffset
+		angle += rate * dt
+		integral += angle * dt
+
+		leftPos, err := c.left.CurrentPositionE()
+		if err != nil {
+			continue
+		}
+		rightPos, err := c.right.CurrentPositionE()
+		if err != nil {
+			continue
+		}
+		motorAngle := float64(leftPos-leftStart+rightPos-rightStart) / 2
+		motorRate := (motorAngle - prevMotorAngle) / dt
+		prevMotorAngle = motorAngle
+
+		if abs(angle) > c.cfg.FallAngle {
+			if fallSince.IsZero() {
+				fallSince = time.Now()
+			} else if time.Since(fallSince) > c.cfg.FallDuration {
+				c.stopMotors()
+				return
+			}
+		} else {
+			fallSince = time.Time{}
+		}
+
+		c.mu.Lock()
+		forward, steer := c.forward, c.steer
+		c.mu.Unlock()
+
+		u := c.cfg.Kp*angle + c.cfg.Ki*integral + c.cfg.Kd*rate +
+			c.cfg.Kpos*motorAngle + c.cfg.Kspd*motorRate - float64(forward)
+
+		c.left.RunForeverE(clamp(u - float64(steer)))
+		c.right.RunForeverE(clamp(u + float64(steer)))
+	}
+}
+
+// stopMotors stops both motors, ignoring errors since the loop is already
+// giving up.
+func (c *Controller) stopMotors() {
+	c.left.StopE()
+	c.right.StopE()
+}
+
+func clamp(v float64) int16 {
+	if v > 100 {
+		return 100
+	}
+	if v < -100 {
+		return -100
+	}
+	return int16(v)
+}
+
+func abs(v float64) float64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
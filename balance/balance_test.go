@@ -0,0 +1,157 @@
+package balance
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeMotor stands in for a *Motor.Motor: it records the last commanded
+// speed and whether it was stopped, without touching real sysfs.
+type fakeMotor struct {
+	mu       sync.Mutex
+	position int32
+	speed    int16
+	stopped  bool
+}
+
+func (m *fakeMotor) CurrentPositionE() (int32, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.position, nil
+}
+
+func (m *fakeMotor) RunForeverE(speed int16) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.speed = speed
+	return nil
+}
+
+func (m *fakeMotor) StopE() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stopped = true
+	return nil
+}
+
+func (m *fakeMotor) lastSpeed() int16 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.speed
+}
+
+func (m *fakeMotor) didStop() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.stopped
+}
+
+// fakeGyro stands in for a *Sensors.GyroSensor with a fixed rate.
+type fakeGyro struct {
+	mu   sync.Mutex
+	rate int16
+}
+
+func (g *fakeGyro) Rate() int16 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.rate
+}
+
+func newTestController(cfg Config, gyro gyroReader, left, right motorDriver) *Controller {
+	return &Controller{
+		cfg:   cfg,
+		left:  left,
+		right: right,
+		gyro:  gyro,
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+}
+
+func TestCalibrateSkipsWhenNonPositive(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.CalibrationTime = 0
+	c := newTestController(cfg, &fakeGyro{rate: 20}, &fakeMotor{}, &fakeMotor{})
+
+	if offset := c.calibrate(); offset != 0 {
+		t.Errorf("calibrate() with non-positive CalibrationTime = %v; want 0", offset)
+	}
+}
+
+func TestCalibratePartiallyConvergesTowardGyroRate(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Period = time.Millisecond
+	cfg.CalibrationTime = 30 * time.Millisecond
+	c := newTestController(cfg, &fakeGyro{rate: 20}, &fakeMotor{}, &fakeMotor{})
+
+	offset := c.calibrate()
+	if offset <= 0 || offset >= 20 {
+		t.Errorf("calibrate() = %v; want a value strictly between 0 and the gyro rate", offset)
+	}
+}
+
+func TestRunDrivesMotorsTowardCorrectingTilt(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Period = time.Millisecond
+	cfg.Kp, cfg.Ki, cfg.Kd, cfg.Kpos, cfg.Kspd = 1, 0, 0, 0, 0
+	left, right := &fakeMotor{}, &fakeMotor{}
+	c := newTestController(cfg, &fakeGyro{rate: 1000}, left, right)
+
+	go c.run(0)
+	time.Sleep(20 * time.Millisecond)
+	c.Stop()
+
+	if left.lastSpeed() <= 0 || right.lastSpeed() <= 0 {
+		t.Errorf("expected both motors driven with a positive speed as tilt accumulated; left=%d right=%d", left.lastSpeed(), right.lastSpeed())
+	}
+	if !left.didStop() || !right.didStop() {
+		t.Error("expected Stop to stop both motors")
+	}
+}
+
+func TestRunGivesUpOnceFallenPastFallDuration(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Period = time.Millisecond
+	cfg.FallAngle = 1
+	cfg.FallDuration = 5 * time.Millisecond
+	left, right := &fakeMotor{}, &fakeMotor{}
+	c := newTestController(cfg, &fakeGyro{rate: 1000}, left, right)
+
+	done := make(chan struct{})
+	go func() {
+		c.run(0)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("run() did not return after the tilt stayed past FallAngle for longer than FallDuration")
+	}
+
+	if !left.didStop() || !right.didStop() {
+		t.Error("expected both motors to be stopped once the controller gives up")
+	}
+}
+
+func TestClampLimitsToMotorRange(t *testing.T) {
+	if v := clamp(150); v != 100 {
+		t.Errorf("clamp(150) = %d; want 100", v)
+	}
+	if v := clamp(-150); v != -100 {
+		t.Errorf("clamp(-150) = %d; want -100", v)
+	}
+	if v := clamp(42); v != 42 {
+		t.Errorf("clamp(42) = %d; want 42", v)
+	}
+}
+
+func TestStartWithConfigRejectsNonPositivePeriod(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Period = 0
+	if _, err := StartWithConfig("A", "B", "1", cfg); err == nil {
+		t.Error("StartWithConfig with zero Period should return an error")
+	}
+}
@@ -0,0 +1,134 @@
+package remote
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ldmberman/GoEV3/Motor"
+	"github.com/ldmberman/GoEV3/Sensors"
+	pb "github.com/ldmberman/GoEV3/remote/proto"
+)
+
+// Client drives motors and sensors on a remote EV3 brick running a Server.
+type Client struct {
+	conn  *grpc.ClientConn
+	stub  pb.EV3Client
+	token string
+}
+
+// Dial connects to the Server at addr and authenticates with token.
+func Dial(addr, token string) (*Client, error) {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn: conn, stub: pb.NewEV3Client(conn), token: token}, nil
+}
+
+// Close closes the underlying connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func (c *Client) ctx() context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), authMetadataKey, c.token)
+}
+
+func outPortToProto(port Motor.OutPort) pb.OutPort {
+	switch port {
+	case Motor.OutPortA:
+		return pb.OutPort_OUT_PORT_A
+	case Motor.OutPortB:
+		return pb.OutPort_OUT_PORT_B
+	case Motor.OutPortC:
+		return pb.OutPort_OUT_PORT_C
+	case Motor.OutPortD:
+		return pb.OutPort_OUT_PORT_D
+	default:
+		return pb.OutPort_OUT_PORT_UNSPECIFIED
+	}
+}
+
+func stopModeToProto(mode Motor.StopMode) pb.StopMode {
+	switch mode {
+	case Motor.Brake:
+		return pb.StopMode_BRAKE
+	case Motor.Hold:
+		return pb.StopMode_HOLD
+	default:
+		return pb.StopMode_COAST
+	}
+}
+
+func inPortToProto(port Sensors.InPort) pb.InPort {
+	switch port {
+	case Sensors.InPort1:
+		return pb.InPort_IN_PORT_1
+	case Sensors.InPort2:
+		return pb.InPort_IN_PORT_2
+	case Sensors.InPort3:
+		return pb.InPort_IN_PORT_3
+	case Sensors.InPort4:
+		return pb.InPort_IN_PORT_4
+	default:
+		return pb.InPort_IN_PORT_UNSPECIFIED
+	}
+}
+
+// RunForever runs the remote motor at port, as Motor.RunForever does locally.
+func (c *Client) RunForever(port Motor.OutPort, speed int16) error {
+	_, err := c.stub.RunForever(c.ctx(), &pb.RunForeverRequest{Port: outPortToProto(port), Speed: int32(speed)})
+	return err
+}
+
+// Rotate moves the remote motor at port by angle degrees, as Motor.Rotate
+// does locally.
+func (c *Client) Rotate(port Motor.OutPort, angle, speed int16) error {
+	_, err := c.stub.Rotate(c.ctx(), &pb.RotateRequest{Port: outPortToProto(port), Angle: int32(angle), Speed: int32(speed)})
+	return err
+}
+
+// RunFor runs the remote motor at port for the given time, as Motor.RunFor
+// does locally.
+func (c *Client) RunFor(port Motor.OutPort, seconds int32, speed int16) error {
+	_, err := c.stub.RunFor(c.ctx(), &pb.RunForRequest{Port: outPortToProto(port), Time: seconds, Speed: int32(speed)})
+	return err
+}
+
+// Stop stops the remote motor at port using mode, as Motor.SetStopMode plus
+// Motor.Stop do locally.
+func (c *Client) Stop(port Motor.OutPort, mode Motor.StopMode) error {
+	_, err := c.stub.Stop(c.ctx(), &pb.StopRequest{Port: outPortToProto(port), Mode: stopModeToProto(mode)})
+	return err
+}
+
+// CurrentPosition reads the remote motor's position, as Motor.CurrentPosition
+// does locally.
+func (c *Client) CurrentPosition(port Motor.OutPort) (int32, error) {
+	reply, err := c.stub.CurrentPosition(c.ctx(), &pb.PositionRequest{Port: outPortToProto(port)})
+	if err != nil {
+		return 0, err
+	}
+	return reply.Position, nil
+}
+
+// SubscribeTouch streams touch sensor values from port until ctx is
+// cancelled, sending each one to ch.
+func (c *Client) SubscribeTouch(ctx context.Context, port Sensors.InPort, ch chan<- uint8) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, authMetadataKey, c.token)
+	stream, err := c.stub.SubscribeTouch(ctx, &pb.TouchSubscribeRequest{Port: inPortToProto(port)})
+	if err != nil {
+		return err
+	}
+
+	for {
+		ev, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		ch <- uint8(ev.Value)
+	}
+}
@@ -0,0 +1,35 @@
+package remote
+
+import (
+	"testing"
+
+	"github.com/ldmberman/GoEV3/Motor"
+	"github.com/ldmberman/GoEV3/Sensors"
+)
+
+func TestModeAllowsEverythingWhenEmpty(t *testing.T) {
+	var m Mode
+	if !m.allowsMotor(Motor.OutPortA) {
+		t.Error("empty Mode should allow every motor port")
+	}
+	if !m.allowsSensor(Sensors.InPort1) {
+		t.Error("empty Mode should allow every sensor port")
+	}
+}
+
+func TestModeRestrictsToListedPorts(t *testing.T) {
+	m := Mode{Motors: []Motor.OutPort{Motor.OutPortA}, Sensors: []Sensors.InPort{Sensors.InPort1}}
+
+	if !m.allowsMotor(Motor.OutPortA) {
+		t.Error("expected OutPortA to be allowed")
+	}
+	if m.allowsMotor(Motor.OutPortB) {
+		t.Error("expected OutPortB to be denied")
+	}
+	if !m.allowsSensor(Sensors.InPort1) {
+		t.Error("expected InPort1 to be allowed")
+	}
+	if m.allowsSensor(Sensors.InPort2) {
+		t.Error("expected InPort2 to be denied")
+	}
+}
@@ -0,0 +1,5 @@
+// Package proto holds the generated client and server stubs for the remote
+// package's gRPC service. Regenerate after editing remote.proto with:
+//
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative remote.proto
+package proto
@@ -0,0 +1,279 @@
+// Package remote exposes Motor and Sensors over gRPC, so a laptop or any
+// other gRPC-capable process can drive the EV3 brick as if it were local.
+package remote
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/ldmberman/GoEV3/Motor"
+	"github.com/ldmberman/GoEV3/Sensors"
+	pb "github.com/ldmberman/GoEV3/remote/proto"
+)
+
+// authMetadataKey is the gRPC metadata key clients must set to the
+// server's Token on every call.
+const authMetadataKey = "authorization"
+
+// Mode limits which motors and sensors a Server exposes to clients. A nil
+// or empty slice means every port of that kind is allowed.
+type Mode struct {
+	Motors  []Motor.OutPort
+	Sensors []Sensors.InPort
+}
+
+// Server implements pb.EV3Server by forwarding RPCs to the local Motor and
+// Sensors packages.
+type Server struct {
+	pb.UnimplementedEV3Server
+
+	Token string
+	Mode  Mode
+}
+
+// NewServer returns a Server that rejects calls without token in their
+// "authorization" metadata and only exposes the ports listed in mode.
+func NewServer(token string, mode Mode) *Server {
+	return &Server{Token: token, Mode: mode}
+}
+
+// Register registers the server's RPCs with g.
+func (s *Server) Register(g *grpc.Server) {
+	pb.RegisterEV3Server(g, s)
+}
+
+func (s *Server) authorize(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	tokens := md.Get(authMetadataKey)
+	if len(tokens) != 1 || tokens[0] != s.Token {
+		return status.Error(codes.Unauthenticated, "invalid or missing token")
+	}
+	return nil
+}
+
+func (m Mode) allowsMotor(port Motor.OutPort) bool {
+	if len(m.Motors) == 0 {
+		return true
+	}
+	for _, p := range m.Motors {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
+
+func (m Mode) allowsSensor(port Sensors.InPort) bool {
+	if len(m.Sensors) == 0 {
+		return true
+	}
+	for _, p := range m.Sensors {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) checkMotor(port Motor.OutPort) error {
+	if !s.Mode.allowsMotor(port) {
+		return status.Errorf(codes.PermissionDenied, "motor port %s is not exposed by this server", port)
+	}
+	return nil
+}
+
+func (s *Server) checkSensor(port Sensors.InPort) error {
+	if !s.Mode.allowsSensor(port) {
+		return status.Errorf(codes.PermissionDenied, "sensor port %s is not exposed by this server", port)
+	}
+	return nil
+}
+
+func outPortFromProto(p pb.OutPort) Motor.OutPort {
+	switch p {
+	case pb.OutPort_OUT_PORT_A:
+		return Motor.OutPortA
+	case pb.OutPort_OUT_PORT_B:
+		return Motor.OutPortB
+	case pb.OutPort_OUT_PORT_C:
+		return Motor.OutPortC
+	case pb.OutPort_OUT_PORT_D:
+		return Motor.OutPortD
+	default:
+		return ""
+	}
+}
+
+func stopModeFromProto(m pb.StopMode) Motor.StopMode {
+	switch m {
+	case pb.StopMode_BRAKE:
+		return Motor.Brake
+	case pb.StopMode_HOLD:
+		return Motor.Hold
+	default:
+		return Motor.Coast
+	}
+}
+
+func inPortFromProto(p pb.InPort) Sensors.InPort {
+	switch p {
+	case pb.InPort_IN_PORT_1:
+		return Sensors.InPort1
+	case pb.InPort_IN_PORT_2:
+		return Sensors.InPort2
+	case pb.InPort_IN_PORT_3:
+		return Sensors.InPort3
+	case pb.InPort_IN_PORT_4:
+		return Sensors.InPort4
+	default:
+		return ""
+	}
+}
+
+// findMotor looks up port, translating a lookup failure into a gRPC error
+// instead of the package-level Motor functions' log.Fatal, so a bad port in
+// a request can't take down the whole server process.
+func (s *Server) findMotor(port Motor.OutPort) (*Motor.Motor, error) {
+	m, err := Motor.FindMotor(port)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "motor port %s: %v", port, err)
+	}
+	return m, nil
+}
+
+// RunForever implements pb.EV3Server.
+func (s *Server) RunForever(ctx context.Context, req *pb.RunForeverRequest) (*pb.Ack, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	port := outPortFromProto(req.Port)
+	if err := s.checkMotor(port); err != nil {
+		return nil, err
+	}
+	m, err := s.findMotor(port)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.RunForeverE(int16(req.Speed)); err != nil {
+		return nil, status.Errorf(codes.Internal, "running motor: %v", err)
+	}
+	return &pb.Ack{}, nil
+}
+
+// Rotate implements pb.EV3Server.
+func (s *Server) Rotate(ctx context.Context, req *pb.RotateRequest) (*pb.Ack, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	port := outPortFromProto(req.Port)
+	if err := s.checkMotor(port); err != nil {
+		return nil, err
+	}
+	m, err := s.findMotor(port)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.RotateE(int16(req.Angle), int16(req.Speed)); err != nil {
+		return nil, status.Errorf(codes.Internal, "rotating motor: %v", err)
+	}
+	return &pb.Ack{}, nil
+}
+
+// RunFor implements pb.EV3Server.
+func (s *Server) RunFor(ctx context.Context, req *pb.RunForRequest) (*pb.Ack, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	port := outPortFromProto(req.Port)
+	if err := s.checkMotor(port); err != nil {
+		return nil, err
+	}
+	m, err := s.findMotor(port)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.RunForE(req.Time, int16(req.Speed)); err != nil {
+		return nil, status.Errorf(codes.Internal, "running motor: %v", err)
+	}
+	return &pb.Ack{}, nil
+}
+
+// Stop implements pb.EV3Server.
+func (s *Server) Stop(ctx context.Context, req *pb.StopRequest) (*pb.Ack, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	port := outPortFromProto(req.Port)
+	if err := s.checkMotor(port); err != nil {
+		return nil, err
+	}
+	m, err := s.findMotor(port)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.SetStopModeE(stopModeFromProto(req.Mode)); err != nil {
+		return nil, status.Errorf(codes.Internal, "setting stop mode: %v", err)
+	}
+	if err := m.StopE(); err != nil {
+		return nil, status.Errorf(codes.Internal, "stopping motor: %v", err)
+	}
+	return &pb.Ack{}, nil
+}
+
+// CurrentPosition implements pb.EV3Server.
+func (s *Server) CurrentPosition(ctx context.Context, req *pb.PositionRequest) (*pb.PositionReply, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	port := outPortFromProto(req.Port)
+	if err := s.checkMotor(port); err != nil {
+		return nil, err
+	}
+	m, err := s.findMotor(port)
+	if err != nil {
+		return nil, err
+	}
+	position, err := m.CurrentPositionE()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading motor position: %v", err)
+	}
+	return &pb.PositionReply{Position: position}, nil
+}
+
+// SubscribeTouch implements pb.EV3Server, streaming a TouchEvent every time
+// the touch sensor's value changes.
+func (s *Server) SubscribeTouch(req *pb.TouchSubscribeRequest, stream pb.EV3_SubscribeTouchServer) error {
+	if err := s.authorize(stream.Context()); err != nil {
+		return err
+	}
+	port := inPortFromProto(req.Port)
+	if err := s.checkSensor(port); err != nil {
+		return err
+	}
+
+	sensor := Sensors.FindTouchSensor(port)
+	watcher := sensor.Watch()
+	ch := make(chan int32, 1)
+	sub := watcher.OnChange(ch, Sensors.Options{})
+	defer sub.Unsubscribe()
+	watcher.Start()
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case v := <-ch:
+			if err := stream.Send(&pb.TouchEvent{Value: uint32(v)}); err != nil {
+				return err
+			}
+		}
+	}
+}
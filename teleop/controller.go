@@ -0,0 +1,245 @@
+package teleop
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ldmberman/GoEV3/Motor"
+)
+
+// ButtonEvent reports a button changing state, as read from an EV_KEY
+// evdev event.
+type ButtonEvent struct {
+	Code    uint16
+	Pressed bool
+}
+
+// Controller drives a pair of motors from a gamepad's analog sticks.
+type Controller struct {
+	Left, Right Motor.OutPort
+
+	// Deadzone is the radius, in raw stick units (|value| up to 32767),
+	// within which stick movement is treated as centered.
+	Deadzone int16
+	// Curve is the exponent of the response curve applied to stick input
+	// past the deadzone. 1 means linear; higher values make the stick less
+	// sensitive near center and more sensitive near full deflection.
+	Curve float64
+
+	// Buttons receives a ButtonEvent for every button press and release.
+	// The caller is expected to keep it drained; teleop drops events rather
+	// than block the read loop if the channel is full.
+	Buttons chan ButtonEvent
+
+	mix func(x, y, ry int16) (left, right int16)
+
+	leftMotor, rightMotor *Motor.Motor
+
+	mu                    sync.Mutex
+	leftSpeed, rightSpeed int16
+	lastEvent             time.Time
+	stopped               bool
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func newController(left, right Motor.OutPort, mix func(x, y, ry int16) (int16, int16)) *Controller {
+	return &Controller{
+		Left:     left,
+		Right:    right,
+		Deadzone: 2000,
+		Curve:    2,
+		Buttons:  make(chan ButtonEvent, 16),
+		mix:      mix,
+	}
+}
+
+// TankDrive returns a Controller where the left stick's Y axis drives the
+// left motor and the right stick's Y axis drives the right motor.
+func TankDrive(left, right Motor.OutPort) *Controller {
+	return newController(left, right, func(_, y, ry int16) (int16, int16) {
+		return y, ry
+	})
+}
+
+// JoystickDrive returns a Controller where a single stick's Y axis controls
+// forward/backward speed and its X axis steers, mixed into independent left
+// and right motor speeds.
+func JoystickDrive(left, right Motor.OutPort) *Controller {
+	return newController(left, right, func(x, y, _ int16) (int16, int16) {
+		return mixJoystick(x, y)
+	})
+}
+
+func mixJoystick(x, y int16) (left, right int16) {
+	l := int32(y) + int32(x)
+	r := int32(y) - int32(x)
+	return clampToInt16(l), clampToInt16(r)
+}
+
+func clampToInt16(v int32) int16 {
+	if v > 32767 {
+		return 32767
+	}
+	if v < -32768 {
+		return -32768
+	}
+	return int16(v)
+}
+
+// applyDeadzone zeroes out values within the deadzone and shifts the rest
+// toward zero by deadzone, so output starts from 0 just past it. The rest is
+// not rescaled: the largest magnitude it returns is the input's largest
+// magnitude minus deadzone.
+func applyDeadzone(v, deadzone int16) int16 {
+	if v > deadzone {
+		v -= deadzone
+	} else if v < -deadzone {
+		v += deadzone
+	} else {
+		return 0
+	}
+	return v
+}
+
+// applyCurve reshapes a stick value by the given exponent, preserving sign
+// and the [-32768, 32767] range. curve == 1 leaves the input unchanged.
+func applyCurve(v int16, curve float64) int16 {
+	if curve == 1 {
+		return v
+	}
+	norm := float64(v) / 32768
+	sign := 1.0
+	if norm < 0 {
+		sign = -1.0
+		norm = -norm
+	}
+	shaped := math.Pow(norm, curve) * sign
+	return clampToInt16(int32(shaped * 32768))
+}
+
+// speedFromStick converts a raw stick axis value to a Motor speed percentage
+// in [-100, 100], after applying the deadzone and response curve.
+func speedFromStick(v, deadzone int16, curve float64) int16 {
+	shaped := applyCurve(applyDeadzone(v, deadzone), curve)
+	return int16(int32(shaped) * 100 / 32767)
+}
+
+// Run reads events from gamepad until Stop is called or the device
+// returns an error, translating stick movement into synchronized motor
+// commands and forwarding button events to Buttons.
+func (c *Controller) Run(gamepad *Gamepad) error {
+	leftMotor, err := Motor.FindMotor(c.Left)
+	if err != nil {
+		return err
+	}
+	rightMotor, err := Motor.FindMotor(c.Right)
+	if err != nil {
+		return err
+	}
+	c.leftMotor, c.rightMotor = leftMotor, rightMotor
+
+	c.mu.Lock()
+	c.stopped = false
+	c.mu.Unlock()
+
+	c.stop = make(chan struct{})
+	c.done = make(chan struct{})
+	defer close(c.done)
+
+	var x, y, ry int16
+	for {
+		select {
+		case <-c.stop:
+			c.leftMotor.StopE()
+			c.rightMotor.StopE()
+			c.setSpeeds(0, 0)
+			return nil
+		default:
+		}
+
+		ev, err := gamepad.ReadEvent()
+		if err != nil {
+			if errors.Is(err, ErrTimeout) {
+				continue
+			}
+			return err
+		}
+
+		switch ev.Type {
+		case evAbs:
+			switch ev.Code {
+			case absX, absRX:
+				x = int16(ev.Value)
+			case absY:
+				y = int16(ev.Value)
+			case absRY:
+				ry = int16(ev.Value)
+			default:
+				continue
+			}
+			left, right := c.mix(x, y, ry)
+			c.drive(speedFromStick(left, c.Deadzone, c.Curve), speedFromStick(right, c.Deadzone, c.Curve))
+		case evKey:
+			c.notifyButton(ButtonEvent{Code: ev.Code, Pressed: ev.Value != 0})
+		}
+	}
+}
+
+// Stop halts Run's read loop and stops both motors.
+func (c *Controller) Stop() {
+	c.mu.Lock()
+	if c.stop == nil || c.stopped {
+		c.mu.Unlock()
+		return
+	}
+	c.stopped = true
+	c.mu.Unlock()
+
+	close(c.stop)
+	<-c.done
+}
+
+func (c *Controller) drive(left, right int16) {
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() { defer wg.Done(); c.leftMotor.RunForeverE(left) }()
+	go func() { defer wg.Done(); c.rightMotor.RunForeverE(right) }()
+	wg.Wait()
+	c.setSpeeds(left, right)
+}
+
+func (c *Controller) setSpeeds(left, right int16) {
+	c.mu.Lock()
+	c.leftSpeed, c.rightSpeed = left, right
+	c.lastEvent = time.Now()
+	c.mu.Unlock()
+}
+
+func (c *Controller) notifyButton(ev ButtonEvent) {
+	select {
+	case c.Buttons <- ev:
+	default:
+		// Caller isn't keeping up; drop the event rather than block reads.
+	}
+	c.mu.Lock()
+	c.lastEvent = time.Now()
+	c.mu.Unlock()
+}
+
+// Status is a snapshot of the controller's current output, used by the HTTP
+// status endpoint.
+type Status struct {
+	LeftSpeed  int16     `json:"leftSpeed"`
+	RightSpeed int16     `json:"rightSpeed"`
+	LastEvent  time.Time `json:"lastEvent"`
+}
+
+func (c *Controller) status() Status {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return Status{LeftSpeed: c.leftSpeed, RightSpeed: c.rightSpeed, LastEvent: c.lastEvent}
+}
@@ -0,0 +1,86 @@
+package teleop
+
+import (
+	"testing"
+
+	"github.com/ldmberman/GoEV3/Motor"
+)
+
+func TestApplyDeadzoneZeroesCenter(t *testing.T) {
+	if v := applyDeadzone(1000, 2000); v != 0 {
+		t.Errorf("applyDeadzone(1000, 2000) = %d; want 0", v)
+	}
+	if v := applyDeadzone(-1000, 2000); v != 0 {
+		t.Errorf("applyDeadzone(-1000, 2000) = %d; want 0", v)
+	}
+}
+
+func TestApplyDeadzonePassesThroughBeyondRadius(t *testing.T) {
+	if v := applyDeadzone(5000, 2000); v != 3000 {
+		t.Errorf("applyDeadzone(5000, 2000) = %d; want 3000", v)
+	}
+	if v := applyDeadzone(-5000, 2000); v != -3000 {
+		t.Errorf("applyDeadzone(-5000, 2000) = %d; want -3000", v)
+	}
+}
+
+func TestApplyCurveLinearIsIdentity(t *testing.T) {
+	if v := applyCurve(12345, 1); v != 12345 {
+		t.Errorf("applyCurve(12345, 1) = %d; want 12345", v)
+	}
+}
+
+func TestApplyCurvePreservesSignAndEndpoints(t *testing.T) {
+	if v := applyCurve(32767, 2); v < 32000 {
+		t.Errorf("applyCurve(32767, 2) = %d; want near 32767", v)
+	}
+	if v := applyCurve(-32768, 2); v > -32000 {
+		t.Errorf("applyCurve(-32768, 2) = %d; want near -32768", v)
+	}
+	if v := applyCurve(0, 2); v != 0 {
+		t.Errorf("applyCurve(0, 2) = %d; want 0", v)
+	}
+}
+
+func TestSpeedFromStickFullDeflection(t *testing.T) {
+	if v := speedFromStick(32767, 0, 1); v != 100 {
+		t.Errorf("speedFromStick(32767, 0, 1) = %d; want 100", v)
+	}
+	if v := speedFromStick(-32768, 0, 1); v != -100 {
+		t.Errorf("speedFromStick(-32768, 0, 1) = %d; want -100", v)
+	}
+}
+
+func TestMixJoystickStraightAhead(t *testing.T) {
+	left, right := mixJoystick(0, 10000)
+	if left != 10000 || right != 10000 {
+		t.Errorf("mixJoystick(0, 10000) = %d, %d; want 10000, 10000", left, right)
+	}
+}
+
+func TestMixJoystickTurningInPlace(t *testing.T) {
+	left, right := mixJoystick(10000, 0)
+	if left != 10000 || right != -10000 {
+		t.Errorf("mixJoystick(10000, 0) = %d, %d; want 10000, -10000", left, right)
+	}
+}
+
+func TestStopIsSafeToCallTwice(t *testing.T) {
+	c := TankDrive(Motor.OutPortA, Motor.OutPortB)
+	c.stop = make(chan struct{})
+	c.done = make(chan struct{})
+	close(c.done)
+
+	c.Stop()
+	c.Stop()
+}
+
+func TestMixJoystickClampsOverflow(t *testing.T) {
+	left, right := mixJoystick(20000, 20000)
+	if left != 32767 {
+		t.Errorf("mixJoystick(20000, 20000) left = %d; want clamped to 32767", left)
+	}
+	if right != 0 {
+		t.Errorf("mixJoystick(20000, 20000) right = %d; want 0", right)
+	}
+}
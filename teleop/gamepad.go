@@ -0,0 +1,115 @@
+// Package teleop reads a Linux evdev gamepad and maps its analog sticks and
+// buttons onto Motor calls, so a rover-style robot can be driven
+// interactively without the caller having to parse evdev events directly.
+package teleop
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// pollTimeout bounds how long ReadEvent can block before returning
+// ErrTimeout, so a caller's read loop gets a chance to notice it's been
+// asked to stop even while the gamepad is idle.
+const pollTimeout = 200 * time.Millisecond
+
+// ErrTimeout is returned by ReadEvent when no event arrives within
+// pollTimeout. It is not a real error: callers should treat it as "nothing
+// happened yet" and read again.
+var ErrTimeout = errors.New("teleop: gamepad read timed out")
+
+// Linux input event types and axis/button codes used by teleop. See
+// linux/input-event-codes.h for the full list.
+const (
+	evSyn = 0x00
+	evKey = 0x01
+	evAbs = 0x03
+
+	absX  = 0x00
+	absY  = 0x01
+	absRX = 0x03
+	absRY = 0x04
+)
+
+// rawEvent mirrors struct input_event on a 32-bit Linux target such as the
+// EV3 brick, where both timeval fields are 32-bit.
+type rawEvent struct {
+	Sec, Usec uint32
+	Type      uint16
+	Code      uint16
+	Value     int32
+}
+
+const rawEventSize = 16
+
+// Event is a decoded gamepad input: either a stick axis moving (Type ==
+// EV_ABS) or a button changing state (Type == EV_KEY).
+type Event struct {
+	Type  uint16
+	Code  uint16
+	Value int32
+}
+
+// Gamepad is an open evdev device, e.g. /dev/input/event3.
+type Gamepad struct {
+	file *os.File
+}
+
+// OpenGamepad opens the evdev device at path for reading.
+func OpenGamepad(path string) (*Gamepad, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("teleop: opening gamepad %s: %w", path, err)
+	}
+	return &Gamepad{file: f}, nil
+}
+
+// Close releases the underlying device file.
+func (g *Gamepad) Close() error {
+	return g.file.Close()
+}
+
+// ReadEvent blocks until the next evdev event is available and returns it,
+// or returns ErrTimeout if none arrives within pollTimeout. EV_SYN
+// synchronization events are skipped.
+func (g *Gamepad) ReadEvent() (Event, error) {
+	var buf [rawEventSize]byte
+	for {
+		if err := g.file.SetReadDeadline(time.Now().Add(pollTimeout)); err != nil {
+			return Event{}, err
+		}
+		if _, err := readFull(g.file, buf[:]); err != nil {
+			if errors.Is(err, os.ErrDeadlineExceeded) {
+				return Event{}, ErrTimeout
+			}
+			return Event{}, err
+		}
+
+		raw := rawEvent{
+			Sec:   binary.LittleEndian.Uint32(buf[0:4]),
+			Usec:  binary.LittleEndian.Uint32(buf[4:8]),
+			Type:  binary.LittleEndian.Uint16(buf[8:10]),
+			Code:  binary.LittleEndian.Uint16(buf[10:12]),
+			Value: int32(binary.LittleEndian.Uint32(buf[12:16])),
+		}
+		if raw.Type == evSyn {
+			continue
+		}
+		return Event{Type: raw.Type, Code: raw.Code, Value: raw.Value}, nil
+	}
+}
+
+func readFull(f *os.File, buf []byte) (int, error) {
+	n := 0
+	for n < len(buf) {
+		m, err := f.Read(buf[n:])
+		if err != nil {
+			return n, err
+		}
+		n += m
+	}
+	return n, nil
+}
@@ -0,0 +1,25 @@
+package teleop
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// StatusHandler returns an http.Handler reporting the controller's current
+// motor speeds and the time of the last gamepad event as JSON, so the robot
+// can be monitored remotely while it is being driven.
+func (c *Controller) StatusHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(c.status())
+	})
+}
+
+// ServeStatus starts an HTTP server on addr exposing the controller's status
+// at "/status". It blocks until the server stops, e.g. with an error from
+// the underlying listener.
+func (c *Controller) ServeStatus(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/status", c.StatusHandler())
+	return http.ListenAndServe(addr, mux)
+}